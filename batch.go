@@ -0,0 +1,99 @@
+package velib
+
+import (
+	"errors"
+	"fmt"
+
+	dbus "github.com/godbus/dbus/v5"
+)
+
+// Tx collects the paths changed during a single Service.Update call.
+type Tx struct {
+	s       *Service
+	changed map[string]map[string]any
+}
+
+// SetValue sets path to value as part of the enclosing Update. Unlike
+// calling SetValue directly on the ServiceValue returned by AddPath,
+// this does not emit its own PropertiesChanged signal: all paths set
+// through a Tx are reported together in the single ItemsChanged signal
+// emitted once Update's function returns.
+func (tx *Tx) SetValue(path string, value any) error {
+	item, ok := tx.s.values[path]
+	if !ok {
+		return fmt.Errorf("no such path %q", path)
+	}
+
+	wrapper, ok := item.(*valueWrapper)
+	if !ok {
+		return fmt.Errorf("value at %q does not support batched updates", path)
+	}
+
+	text, err := wrapper.base.setValueLocked(value)
+	if err != nil {
+		return fmt.Errorf("failed to set value for %s: %w", path, err)
+	}
+
+	tx.changed[path] = map[string]any{
+		"Value": value,
+		"Text":  text,
+	}
+
+	return nil
+}
+
+// Update applies fn to the service atomically, holding the service
+// lock for the duration of fn, and then emits a single
+// com.victronenergy.BusItem.ItemsChanged signal covering every path fn
+// changed via tx.SetValue. Consumers that drive several correlated
+// values per tick (Dc/0/Voltage, Dc/0/Current, Dc/0/Power, Soc, ...)
+// should use Update instead of calling SetValue on each path, so the
+// bus sees one coherent update rather than N separate signals.
+func (s *Service) Update(fn func(tx *Tx) error) error {
+	s.mu.Lock()
+
+	tx := &Tx{
+		s:       s,
+		changed: make(map[string]map[string]any),
+	}
+
+	err := fn(tx)
+
+	// Snapshot the connection before releasing s.mu: Run can swap it
+	// out for a new one on reconnect concurrently with this emit.
+	conn, name := s.conn, s.name
+
+	s.mu.Unlock()
+
+	if len(tx.changed) == 0 {
+		return err
+	}
+
+	// Paths fn set before returning an error keep their new values, so
+	// they are still reported; fn's error is returned after the emit.
+	obj := conn.Object(name, dbus.ObjectPath("/"))
+
+	if emitErr := conn.Emit(
+		obj.Path(),
+		"com.victronenergy.BusItem.ItemsChanged",
+		tx.changed,
+	); emitErr != nil {
+		return errors.Join(err, fmt.Errorf("failed to emit ItemsChanged: %w", emitErr))
+	}
+
+	return err
+}
+
+// SetValues is a convenience wrapper around Update for setting several
+// paths to fixed values in one atomic, single-signal update.
+func (s *Service) SetValues(values map[string]any) error {
+	return s.Update(func(tx *Tx) error {
+		for path, value := range values {
+			if err := tx.SetValue(path, value); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,247 @@
+package velib
+
+import (
+	"fmt"
+	"sync"
+
+	dbus "github.com/godbus/dbus/v5"
+)
+
+// Item is the decoded value of a single path as returned by GetItems
+// or delivered to a Subscribe handler: the raw value together with
+// its pre-formatted text representation.
+type Item struct {
+	Value any
+	Text  string
+}
+
+// SignalHandler is called whenever the value at the subscribed path
+// changes, either because the remote service emitted a
+// PropertiesChanged signal for that path, or because an ItemsChanged
+// signal included it.
+type SignalHandler func(value any, text string)
+
+// Client talks to a remote com.victronenergy.BusItem service, the
+// counterpart of the paths a Service exports. It is the building
+// block for things like a battery aggregator or a DVCC-aware charger
+// that needs to read com.victronenergy.system and other services
+// without dropping down to raw godbus calls.
+type Client struct {
+	mu       sync.Mutex
+	conn     *dbus.Conn
+	name     string
+	sender   string
+	handlers map[string][]SignalHandler
+	sigCh    chan *dbus.Signal
+}
+
+// NewClient returns a Client for the remote service name (for example
+// "com.victronenergy.system"). It subscribes to the service's
+// PropertiesChanged and ItemsChanged signals so that Subscribe
+// handlers start receiving updates immediately. Callers are
+// responsible for calling Close once the Client is no longer needed.
+func NewClient(conn *dbus.Conn, name string) (*Client, error) {
+	// conn.Signal fans every received signal out to every registered
+	// channel regardless of which AddMatchSignal rule matched it, so
+	// a second Client on the same connection would otherwise see this
+	// Client's signals too whenever paths collide (many are shared
+	// across Victron services, e.g. /Dc/0/Voltage). Resolve name to
+	// its unique bus name up front and filter on sig.Sender instead.
+	sender, err := resolveUniqueName(conn, name)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Client{
+		conn:     conn,
+		name:     name,
+		sender:   sender,
+		handlers: make(map[string][]SignalHandler),
+	}
+
+	if err := conn.AddMatchSignal(
+		dbus.WithMatchSender(name),
+		dbus.WithMatchInterface("com.victronenergy.BusItem"),
+	); err != nil {
+		return nil, fmt.Errorf("failed to add signal match for %s: %w", name, err)
+	}
+
+	c.sigCh = make(chan *dbus.Signal, 16)
+	conn.Signal(c.sigCh)
+
+	go c.handleSignals(c.sigCh)
+
+	return c, nil
+}
+
+// Close removes the signal match installed by NewClient and stops the
+// goroutine dispatching signals to Subscribe handlers. It does not
+// close the underlying connection, which the caller owns.
+func (c *Client) Close() error {
+	c.conn.RemoveSignal(c.sigCh)
+
+	err := c.conn.RemoveMatchSignal(
+		dbus.WithMatchSender(c.name),
+		dbus.WithMatchInterface("com.victronenergy.BusItem"),
+	)
+
+	close(c.sigCh)
+
+	if err != nil {
+		return fmt.Errorf("failed to remove signal match for %s: %w", c.name, err)
+	}
+
+	return nil
+}
+
+func resolveUniqueName(conn *dbus.Conn, name string) (string, error) {
+	var unique string
+	if err := conn.BusObject().Call("org.freedesktop.DBus.GetNameOwner", 0, name).Store(&unique); err != nil {
+		return "", fmt.Errorf("failed to resolve owner of %s: %w", name, err)
+	}
+
+	return unique, nil
+}
+
+// GetValue returns the current value at path.
+func (c *Client) GetValue(path string) (any, error) {
+	obj := c.conn.Object(c.name, dbus.ObjectPath(path))
+
+	var value dbus.Variant
+	if err := obj.Call("GetValue", 0).Store(&value); err != nil {
+		return nil, fmt.Errorf("failed to get value for %s: %w", path, err)
+	}
+
+	return value.Value(), nil
+}
+
+// GetText returns the formatted text of the value at path.
+func (c *Client) GetText(path string) (string, error) {
+	obj := c.conn.Object(c.name, dbus.ObjectPath(path))
+
+	var text string
+	if err := obj.Call("GetText", 0).Store(&text); err != nil {
+		return "", fmt.Errorf("failed to get text for %s: %w", path, err)
+	}
+
+	return text, nil
+}
+
+// SetValue requests that the remote service set path to value.
+func (c *Client) SetValue(path string, value any) error {
+	obj := c.conn.Object(c.name, dbus.ObjectPath(path))
+
+	var result int
+	if err := obj.Call("SetValue", 0, dbus.MakeVariant(value)).Store(&result); err != nil {
+		return fmt.Errorf("failed to set value for %s: %w", path, err)
+	}
+
+	if result != 0 {
+		return fmt.Errorf("unexpected result %d setting %s", result, path)
+	}
+
+	return nil
+}
+
+// GetItems returns every path the remote service currently exposes,
+// decoded from the a{sa{sv}} GetItems payload into a typed map.
+func (c *Client) GetItems() (map[string]Item, error) {
+	obj := c.conn.Object(c.name, dbus.ObjectPath("/"))
+
+	var raw map[string]map[string]dbus.Variant
+	if err := obj.Call("GetItems", 0).Store(&raw); err != nil {
+		return nil, fmt.Errorf("failed to get items from %s: %w", c.name, err)
+	}
+
+	out := make(map[string]Item, len(raw))
+	for path, fields := range raw {
+		out[path] = itemFromFields(fields)
+	}
+
+	return out, nil
+}
+
+// Subscribe registers handler to be called, with locking that mirrors
+// baseValue, whenever the value at path changes. The initial value is
+// not delivered; call GetValue first if the current value is needed.
+func (c *Client) Subscribe(path string, handler SignalHandler) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.handlers[path] = append(c.handlers[path], handler)
+}
+
+func (c *Client) handleSignals(ch chan *dbus.Signal) {
+	for sig := range ch {
+		switch sig.Name {
+		case "com.victronenergy.BusItem.PropertiesChanged":
+			c.dispatchPropertiesChanged(sig)
+		case "com.victronenergy.BusItem.ItemsChanged":
+			c.dispatchItemsChanged(sig)
+		}
+	}
+}
+
+func (c *Client) dispatchPropertiesChanged(sig *dbus.Signal) {
+	if sig.Sender != c.sender {
+		return
+	}
+
+	if len(sig.Body) != 1 {
+		return
+	}
+
+	fields, ok := sig.Body[0].(map[string]dbus.Variant)
+	if !ok {
+		return
+	}
+
+	item := itemFromFields(fields)
+
+	c.notify(string(sig.Path), item)
+}
+
+func (c *Client) dispatchItemsChanged(sig *dbus.Signal) {
+	if sig.Sender != c.sender {
+		return
+	}
+
+	if len(sig.Body) != 1 {
+		return
+	}
+
+	items, ok := sig.Body[0].(map[string]map[string]dbus.Variant)
+	if !ok {
+		return
+	}
+
+	for path, fields := range items {
+		c.notify(path, itemFromFields(fields))
+	}
+}
+
+func (c *Client) notify(path string, item Item) {
+	c.mu.Lock()
+	handlers := append([]SignalHandler(nil), c.handlers[path]...)
+	c.mu.Unlock()
+
+	for _, handler := range handlers {
+		handler(item.Value, item.Text)
+	}
+}
+
+func itemFromFields(fields map[string]dbus.Variant) Item {
+	var item Item
+
+	if v, ok := fields["Value"]; ok {
+		item.Value = v.Value()
+	}
+
+	if v, ok := fields["Text"]; ok {
+		if text, ok := v.Value().(string); ok {
+			item.Text = text
+		}
+	}
+
+	return item
+}
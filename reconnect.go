@@ -0,0 +1,160 @@
+package velib
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"time"
+
+	dbus "github.com/godbus/dbus/v5"
+)
+
+// ReconnectEvent is sent on the channel passed to Service.Run whenever
+// the connection is lost or (re)established. Err is nil on a
+// successful (re)connect and set while a disconnect or a failed
+// reconnect attempt is being reported.
+type ReconnectEvent struct {
+	Err error
+}
+
+// WithReconnect configures NewService to use dial to open a new
+// connection whenever Run notices the current one has dropped. dial
+// should reopen the same bus the original connection came from (the
+// system bus on a Cerbo GX, typically), since Run has no way to infer
+// that itself.
+func WithReconnect(dial func() (*dbus.Conn, error)) ServiceOption {
+	return func(s *Service) {
+		s.dial = dial
+	}
+}
+
+// Run watches the service's D-Bus connection and keeps the service
+// registered, which matters because dbus-daemon restarting (common on
+// a Cerbo GX during firmware updates) otherwise leaves the service
+// silently unregistered. On disconnect it rebuilds the connection
+// using the dial function passed to WithReconnect, re-exports every
+// path added via AddPath plus the introspection nodes, re-issues
+// AddSetting/GetDeviceInstance, re-requests the well-known name, and
+// re-emits every path's current value so subscribers resync.
+// Reconnect attempts back off exponentially up to one minute. Run
+// blocks until ctx is cancelled; events, if non-nil, receives a
+// ReconnectEvent for every disconnect, failed attempt, and successful
+// reconnect, and is never blocked on by a slow consumer.
+func (s *Service) Run(ctx context.Context, events chan<- ReconnectEvent) error {
+	if s.dial == nil {
+		return fmt.Errorf("service %s: Run requires WithReconnect to be passed to NewService", s.name)
+	}
+
+	const pingInterval = 5 * time.Second
+
+	ticker := time.NewTicker(pingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			conn, _ := s.connection()
+
+			if conn.BusObject().Call("org.freedesktop.DBus.Peer.Ping", 0).Err == nil {
+				continue
+			}
+
+			if err := s.reconnect(ctx, events); err != nil {
+				return fmt.Errorf("failed to reconnect %s: %w", s.name, err)
+			}
+		}
+	}
+}
+
+func (s *Service) reconnect(ctx context.Context, events chan<- ReconnectEvent) error {
+	notify := func(err error) {
+		if events == nil {
+			return
+		}
+
+		select {
+		case events <- ReconnectEvent{Err: err}:
+		default:
+		}
+	}
+
+	notify(fmt.Errorf("disconnected from bus"))
+
+	backoff := time.Second
+	const maxBackoff = time.Minute
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		conn, err := s.dial()
+		if err == nil {
+			s.mu.Lock()
+			s.conn = conn
+			s.settings = NewSettings(conn)
+			s.deviceInstance = -1
+			s.mu.Unlock()
+
+			if err = s.reexport(); err == nil {
+				notify(nil)
+				return nil
+			}
+		}
+
+		notify(err)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+
+		if backoff *= 2; backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+}
+
+// reexport re-registers the service and every path it has previously
+// added against the (newly dialed) connection, and re-emits each
+// path's current value so subscribers resync.
+func (s *Service) reexport() error {
+	if _, err := s.GetDeviceInstance(); err != nil {
+		return fmt.Errorf("failed to re-register device instance: %w", err)
+	}
+
+	if err := s.Register(); err != nil {
+		return fmt.Errorf("failed to re-register service: %w", err)
+	}
+
+	s.mu.Lock()
+	values := maps.Clone(s.values)
+	s.mu.Unlock()
+
+	for path, item := range values {
+		wrapper, ok := item.(*valueWrapper)
+		if !ok {
+			continue
+		}
+
+		if err := s.exportPath(path, wrapper); err != nil {
+			return fmt.Errorf("failed to re-export %s: %w", path, err)
+		}
+
+		// Republish, not SetValue: resyncing subscribers after a
+		// reconnect must not re-run validation or re-invoke OnSet,
+		// which would re-actuate its side effect on every reconnect
+		// and, if it returns a transient error, abort reexport and
+		// make reconnect back off even though the bus is healthy.
+		if err := wrapper.base.republish(); err != nil {
+			return fmt.Errorf("failed to re-emit value for %s: %w", path, err)
+		}
+	}
+
+	return nil
+}
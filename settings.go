@@ -0,0 +1,192 @@
+package velib
+
+import (
+	"fmt"
+
+	dbus "github.com/godbus/dbus/v5"
+)
+
+// Settings wraps the com.victronenergy.settings service (localsettings)
+// used to persist user configuration such as charge limits, custom
+// names, or thresholds, the way the Python SettingsDevice helper does.
+type Settings struct {
+	conn *dbus.Conn
+}
+
+// NewSettings returns a Settings bound to conn.
+func NewSettings(conn *dbus.Conn) *Settings {
+	return &Settings{
+		conn: conn,
+	}
+}
+
+// SettingOption configures an AddSetting call.
+type SettingOption func(*settingConfig)
+
+type settingConfig struct {
+	min, max any
+}
+
+// WithMin sets the setting's minimum value.
+func WithMin(min any) SettingOption {
+	return func(c *settingConfig) {
+		c.min = min
+	}
+}
+
+// WithMax sets the setting's maximum value.
+func WithMax(max any) SettingOption {
+	return func(c *settingConfig) {
+		c.max = max
+	}
+}
+
+// AddSetting creates group/name with defaultValue if it does not
+// already exist, and returns its path. ItemType is inferred from the
+// Go type of defaultValue (int, int64, float64 or string).
+func (s *Settings) AddSetting(group, name string, defaultValue any, opts ...SettingOption) (string, error) {
+	cfg := settingConfig{
+		min: "",
+		max: "",
+	}
+
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	itemType, err := settingItemType(defaultValue)
+	if err != nil {
+		return "", fmt.Errorf("failed to determine item type for %s/%s: %w", group, name, err)
+	}
+
+	var result int
+	err = s.conn.Object("com.victronenergy.settings", dbus.ObjectPath("/Settings")).Call(
+		"AddSetting",
+		0,
+		group,
+		name,
+		dbus.MakeVariant(defaultValue),
+		itemType,
+		dbus.MakeVariant(cfg.min),
+		dbus.MakeVariant(cfg.max),
+	).Store(&result)
+	if err != nil {
+		return "", fmt.Errorf("failed to add setting %s/%s: %w", group, name, err)
+	}
+
+	if result != 0 {
+		return "", fmt.Errorf("unexpected result %d adding setting %s/%s", result, group, name)
+	}
+
+	return "/Settings/" + group + "/" + name, nil
+}
+
+// GetValue returns the current value of the setting at path.
+func (s *Settings) GetValue(path string) (any, error) {
+	obj := s.conn.Object("com.victronenergy.settings", dbus.ObjectPath(path))
+
+	var value dbus.Variant
+	if err := obj.Call("GetValue", 0).Store(&value); err != nil {
+		return nil, fmt.Errorf("failed to get value for %s: %w", path, err)
+	}
+
+	return value.Value(), nil
+}
+
+// SetValue sets the setting at path to value.
+func (s *Settings) SetValue(path string, value any) error {
+	obj := s.conn.Object("com.victronenergy.settings", dbus.ObjectPath(path))
+
+	var result int
+	if err := obj.Call("SetValue", 0, dbus.MakeVariant(value)).Store(&result); err != nil {
+		return fmt.Errorf("failed to set value for %s: %w", path, err)
+	}
+
+	if result != 0 {
+		return fmt.Errorf("unexpected result %d setting value for %s", result, path)
+	}
+
+	return nil
+}
+
+// RemoveSettings removes the settings at paths.
+func (s *Settings) RemoveSettings(paths ...string) error {
+	var result int
+	if err := s.conn.Object("com.victronenergy.settings", dbus.ObjectPath("/Settings")).Call(
+		"RemoveSettings",
+		0,
+		paths,
+	).Store(&result); err != nil {
+		return fmt.Errorf("failed to remove settings %v: %w", paths, err)
+	}
+
+	if result != 0 {
+		return fmt.Errorf("unexpected result %d removing settings %v", result, paths)
+	}
+
+	return nil
+}
+
+// Watch calls handler with the new value every time the setting at
+// path changes. It returns a cancel func that removes the match rule,
+// stops the signal channel, and ends the dispatch goroutine; callers
+// must call it once the watch is no longer needed.
+func (s *Settings) Watch(path string, handler func(value any)) (func(), error) {
+	matchOpts := []dbus.MatchOption{
+		dbus.WithMatchInterface("com.victronenergy.BusItem"),
+		dbus.WithMatchMember("PropertiesChanged"),
+		dbus.WithMatchObjectPath(dbus.ObjectPath(path)),
+	}
+
+	if err := s.conn.AddMatchSignal(matchOpts...); err != nil {
+		return nil, fmt.Errorf("failed to watch %s: %w", path, err)
+	}
+
+	ch := make(chan *dbus.Signal, 16)
+	s.conn.Signal(ch)
+
+	go func() {
+		for sig := range ch {
+			if sig.Name != "com.victronenergy.BusItem.PropertiesChanged" || sig.Path != dbus.ObjectPath(path) {
+				continue
+			}
+
+			if len(sig.Body) != 1 {
+				continue
+			}
+
+			changes, ok := sig.Body[0].(map[string]dbus.Variant)
+			if !ok {
+				continue
+			}
+
+			value, ok := changes["Value"]
+			if !ok {
+				continue
+			}
+
+			handler(value.Value())
+		}
+	}()
+
+	cancel := func() {
+		s.conn.RemoveSignal(ch)
+		_ = s.conn.RemoveMatchSignal(matchOpts...)
+		close(ch)
+	}
+
+	return cancel, nil
+}
+
+func settingItemType(value any) (string, error) {
+	switch value.(type) {
+	case int, int64:
+		return "i", nil
+	case float64:
+		return "f", nil
+	case string:
+		return "s", nil
+	default:
+		return "", fmt.Errorf("unsupported setting type %T", value)
+	}
+}
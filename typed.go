@@ -0,0 +1,270 @@
+package velib
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/godbus/dbus/v5/introspect"
+)
+
+// TypedValueType is the set of Go types TypedValue supports.
+type TypedValueType interface {
+	float64 | int64 | string | bool
+}
+
+// RangedValue is implemented by ServiceValue values that have a
+// meaningful minimum and maximum, exposed over D-Bus through the
+// GetMin/GetMax methods so tools like VRM or dbus-spy can render a
+// sensible UI for the path.
+type RangedValue interface {
+	GetMin() (any, error)
+	GetMax() (any, error)
+}
+
+// TypedValue is a generic ServiceValue for T. Unlike the untyped value
+// stored by AddPath(path, any), it validates SetValue calls against
+// Min/Max, coerces incoming D-Bus variants to T (godbus decodes
+// numbers as their exact wire type, so int32/uint32/float64 all need
+// normalising), and derives its text form from Unit.
+type TypedValue[T TypedValueType] struct {
+	mu sync.Mutex
+
+	value T
+
+	// Min and Max bound SetValue when set; they are ignored for T ==
+	// string or T == bool.
+	Min, Max *T
+
+	// Unit is appended to the value to build its text form, e.g. "V"
+	// or "%".
+	Unit string
+
+	// Writable is exposed through introspection so that remote tools
+	// can tell whether the path accepts SetValue calls, and is
+	// enforced by baseValue.SetValue: a remote SetValue on a
+	// Writable: false path is rejected. It does not gate Go-level
+	// calls such as wrapper.SetValue, since the driver that owns the
+	// path must always be able to update it regardless of whether
+	// remote clients may.
+	Writable bool
+
+	// OnSet, if set, is called with the coerced value before it is
+	// stored; returning an error rejects the SetValue call.
+	OnSet func(T) error
+}
+
+// NewTypedValue returns a TypedValue holding value, with no bounds and
+// no unit. Use the struct fields to configure Min, Max, Unit,
+// Writable, and OnSet.
+func NewTypedValue[T TypedValueType](value T) *TypedValue[T] {
+	return &TypedValue[T]{
+		value: value,
+	}
+}
+
+func (t *TypedValue[T]) GetValue() (any, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.value, nil
+}
+
+func (t *TypedValue[T]) GetText() (string, error) {
+	t.mu.Lock()
+	value := t.value
+	t.mu.Unlock()
+
+	if t.Unit == "" {
+		return fmt.Sprintf("%v", value), nil
+	}
+
+	if t.Unit == "%" {
+		return fmt.Sprintf("%v%s", value, t.Unit), nil
+	}
+
+	return fmt.Sprintf("%v %s", value, t.Unit), nil
+}
+
+func (t *TypedValue[T]) SetValue(value any) error {
+	v, err := coerceTo[T](value)
+	if err != nil {
+		return fmt.Errorf("failed to coerce %v (%T): %w", value, value, err)
+	}
+
+	if err := t.validateRange(v); err != nil {
+		return err
+	}
+
+	if t.OnSet != nil {
+		if err := t.OnSet(v); err != nil {
+			return err
+		}
+	}
+
+	t.mu.Lock()
+	t.value = v
+	t.mu.Unlock()
+
+	return nil
+}
+
+func (t *TypedValue[T]) isWritable() bool {
+	return t.Writable
+}
+
+// IntrospectProperties exposes Writable, and Unit/Min/Max when set,
+// as read-only D-Bus properties in the path's introspection XML.
+func (t *TypedValue[T]) IntrospectProperties() []introspect.Property {
+	props := []introspect.Property{
+		{Name: "Writable", Type: "b", Access: "read"},
+	}
+
+	if t.Unit != "" {
+		props = append(props, introspect.Property{Name: "Unit", Type: "s", Access: "read"})
+	}
+
+	sig := typedSignature[T]()
+
+	if t.Min != nil {
+		props = append(props, introspect.Property{Name: "Min", Type: sig, Access: "read"})
+	}
+
+	if t.Max != nil {
+		props = append(props, introspect.Property{Name: "Max", Type: sig, Access: "read"})
+	}
+
+	return props
+}
+
+func typedSignature[T TypedValueType]() string {
+	var zero T
+
+	switch any(zero).(type) {
+	case float64:
+		return "d"
+	case int64:
+		return "x"
+	case string:
+		return "s"
+	case bool:
+		return "b"
+	default:
+		return "v"
+	}
+}
+
+func (t *TypedValue[T]) GetMin() (any, error) {
+	if t.Min == nil {
+		return nil, fmt.Errorf("no minimum set")
+	}
+
+	return *t.Min, nil
+}
+
+func (t *TypedValue[T]) GetMax() (any, error) {
+	if t.Max == nil {
+		return nil, fmt.Errorf("no maximum set")
+	}
+
+	return *t.Max, nil
+}
+
+func (t *TypedValue[T]) validateRange(v T) error {
+	switch vv := any(v).(type) {
+	case float64:
+		if t.Min != nil && vv < any(*t.Min).(float64) {
+			return fmt.Errorf("value %v below minimum %v", vv, *t.Min)
+		}
+
+		if t.Max != nil && vv > any(*t.Max).(float64) {
+			return fmt.Errorf("value %v above maximum %v", vv, *t.Max)
+		}
+	case int64:
+		if t.Min != nil && vv < any(*t.Min).(int64) {
+			return fmt.Errorf("value %v below minimum %v", vv, *t.Min)
+		}
+
+		if t.Max != nil && vv > any(*t.Max).(int64) {
+			return fmt.Errorf("value %v above maximum %v", vv, *t.Max)
+		}
+	}
+
+	return nil
+}
+
+func coerceTo[T TypedValueType](value any) (T, error) {
+	var zero T
+
+	switch any(zero).(type) {
+	case float64:
+		f, err := toFloat64(value)
+		if err != nil {
+			return zero, err
+		}
+
+		return any(f).(T), nil
+	case int64:
+		i, err := toInt64(value)
+		if err != nil {
+			return zero, err
+		}
+
+		return any(i).(T), nil
+	case string:
+		s, ok := value.(string)
+		if !ok {
+			return zero, fmt.Errorf("expected string, got %T", value)
+		}
+
+		return any(s).(T), nil
+	case bool:
+		b, ok := value.(bool)
+		if !ok {
+			return zero, fmt.Errorf("expected bool, got %T", value)
+		}
+
+		return any(b).(T), nil
+	default:
+		return zero, fmt.Errorf("unsupported type %T", zero)
+	}
+}
+
+func toFloat64(value any) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case float32:
+		return float64(v), nil
+	case int:
+		return float64(v), nil
+	case int32:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case uint32:
+		return float64(v), nil
+	case uint64:
+		return float64(v), nil
+	default:
+		return 0, fmt.Errorf("cannot convert %T to float64", value)
+	}
+}
+
+func toInt64(value any) (int64, error) {
+	switch v := value.(type) {
+	case int64:
+		return v, nil
+	case int32:
+		return int64(v), nil
+	case int:
+		return int64(v), nil
+	case uint32:
+		return int64(v), nil
+	case uint64:
+		return int64(v), nil
+	case float64:
+		return int64(v), nil
+	default:
+		return 0, fmt.Errorf("cannot convert %T to int64", value)
+	}
+}
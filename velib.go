@@ -21,12 +21,18 @@ type Service struct {
 	deviceInstance int
 	deviceName     string
 	deviceClass    string
+	dial           func() (*dbus.Conn, error)
+	settings       *Settings
 }
 
+// ServiceOption configures optional Service behaviour at construction
+// time.
+type ServiceOption func(*Service)
+
 var nonAlphanumberic = regexp.MustCompile("[^a-zA-Z0-9]+")
 
 // TODO: validate name
-func NewService(conn *dbus.Conn, name string) (*Service, error) {
+func NewService(conn *dbus.Conn, name string, opts ...ServiceOption) (*Service, error) {
 	parts := strings.Split(name, ".")
 	if len(parts) < 3 {
 		return nil, fmt.Errorf("name %q must have at least 3 parts", name)
@@ -49,9 +55,25 @@ func NewService(conn *dbus.Conn, name string) (*Service, error) {
 		deviceInstance: -1,
 	}
 
+	s.settings = NewSettings(conn)
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
 	return s, nil
 }
 
+// connection returns the current D-Bus connection and service name
+// under s.mu, since Service.Run can replace the connection on a
+// reconnect concurrently with callers driving values.
+func (s *Service) connection() (*dbus.Conn, string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.conn, s.name
+}
+
 func (s *Service) Close() error {
 	reply, err := s.conn.ReleaseName(s.name)
 	if err != nil {
@@ -70,19 +92,23 @@ func (s *Service) GetDeviceInstance() (int, error) {
 		return s.deviceInstance, nil
 	}
 
-	getDeviceInstance := func() (int, error) {
-		obj := s.conn.Object("com.victronenergy.settings",
-			dbus.ObjectPath("/Settings/Devices/"+s.deviceName+"/ClassAndVrmInstance"))
+	path := "/Settings/Devices/" + s.deviceName + "/ClassAndVrmInstance"
 
-		var value string
-		if err := obj.Call("GetValue", 0).Store(&value); err != nil {
+	getDeviceInstance := func() (int, error) {
+		value, err := s.settings.GetValue(path)
+		if err != nil {
 			return 0, fmt.Errorf("failed to get value: %w", err)
 		}
 
-		parts := strings.Split(value, ":")
+		text, ok := value.(string)
+		if !ok {
+			return 0, fmt.Errorf("unexpected value type %T", value)
+		}
+
+		parts := strings.Split(text, ":")
 
 		if len(parts) != 2 {
-			return 0, fmt.Errorf("unexpected value %q", value)
+			return 0, fmt.Errorf("unexpected value %q", text)
 		}
 
 		return strconv.Atoi(parts[1])
@@ -94,25 +120,14 @@ func (s *Service) GetDeviceInstance() (int, error) {
 	}
 
 	// See https://github.com/victronenergy/localsettings?tab=readme-ov-file#using-addsetting-to-allocate-a-vrm-device-instance
-	var result int
-	err = s.conn.Object("com.victronenergy.settings", "/Settings/Devices").Call(
-		"AddSetting",
-		0,
-		s.deviceName,          // group
-		"ClassAndVrmInstance", // name
-		dbus.MakeVariant(fmt.Sprintf("%s:%d", s.deviceClass, deviceInstance)), // defaultValue
-		"s",                  // itemType
-		dbus.MakeVariant(""), // minimum
-		dbus.MakeVariant(""), // maximum
-	).Store(&result)
-	if err != nil {
+	if _, err := s.settings.AddSetting(
+		"Devices/"+s.deviceName,
+		"ClassAndVrmInstance",
+		fmt.Sprintf("%s:%d", s.deviceClass, deviceInstance),
+	); err != nil {
 		return -1, fmt.Errorf("failed to store result: %w", err)
 	}
 
-	if result != 0 {
-		return -1, fmt.Errorf("unexpected result %d", result)
-	}
-
 	deviceInstance, err = getDeviceInstance()
 	if err != nil {
 		return -1, fmt.Errorf("failed to get device instance: %w", err)
@@ -194,30 +209,7 @@ func (s *Service) AddPath(path string, value any) (ServiceValue, error) {
 
 	fmt.Printf("AddPath %s %s %T\n", s.name, path, wrapper.base.value)
 
-	obj := s.conn.Object(s.name, dbus.ObjectPath(path))
-
-	err := s.conn.ExportAll(
-		wrapper.base,
-		obj.Path(),
-		"com.victronenergy.BusItem",
-	)
-	if err != nil {
-		return nil, fmt.Errorf("failed to export service value: %w", err)
-	}
-
-	node := &introspect.Node{}
-	node.Name = "com.victronenergy.BusItem"
-	iface := &introspect.Interface{}
-	iface.Name = "com.victronenergy.BusItem"
-	iface.Methods = introspect.Methods(wrapper.base)
-	node.Interfaces = append(node.Interfaces, *iface)
-	dbusXMLinsp := introspect.NewIntrospectable(node)
-
-	err = s.conn.Export(
-		dbusXMLinsp,
-		obj.Path(),
-		"org.freedesktop.DBus.Introspectable")
-	if err != nil {
+	if err := s.exportPath(path, wrapper); err != nil {
 		return nil, err
 	}
 
@@ -239,6 +231,51 @@ func (s *Service) AddPath(path string, value any) (ServiceValue, error) {
 	return wrapper, nil
 }
 
+// Introspectable is implemented by ServiceValue values that contribute
+// extra D-Bus properties (such as Min, Max, Unit, or Writable) to
+// their path's introspection XML.
+type Introspectable interface {
+	IntrospectProperties() []introspect.Property
+}
+
+// exportPath exports wrapper's underlying baseValue and its
+// introspection node at path. It is shared by AddPath and by
+// reexport, which re-runs it against a new connection after Run
+// reconnects.
+func (s *Service) exportPath(path string, wrapper *valueWrapper) error {
+	obj := s.conn.Object(s.name, dbus.ObjectPath(path))
+
+	if err := s.conn.ExportAll(
+		wrapper.base,
+		obj.Path(),
+		"com.victronenergy.BusItem",
+	); err != nil {
+		return fmt.Errorf("failed to export service value: %w", err)
+	}
+
+	node := &introspect.Node{}
+	node.Name = "com.victronenergy.BusItem"
+	iface := &introspect.Interface{}
+	iface.Name = "com.victronenergy.BusItem"
+	iface.Methods = introspect.Methods(wrapper.base)
+
+	if p, ok := wrapper.base.value.(Introspectable); ok {
+		iface.Properties = p.IntrospectProperties()
+	}
+
+	node.Interfaces = append(node.Interfaces, *iface)
+	dbusXMLinsp := introspect.NewIntrospectable(node)
+
+	if err := s.conn.Export(
+		dbusXMLinsp,
+		obj.Path(),
+		"org.freedesktop.DBus.Introspectable"); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func wrapError(err error) *dbus.Error {
 	if err == nil {
 		return nil
@@ -266,13 +303,17 @@ type valueWrapper struct {
 	base *baseValue
 }
 
+// SetValue updates the path from driver code. It intentionally does
+// not go through baseValue.SetValue's Writable check, since that gate
+// is about whether remote clients may call the D-Bus SetValue method,
+// not whether the driver that owns the path may update it.
 func (w *valueWrapper) SetValue(value any) error {
-	_, err := w.base.SetValue(value)
+	text, err := w.base.setValueLocked(value)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return w.base.emitPropertiesChanged(value, text)
 }
 
 func (w *valueWrapper) GetValue() (any, error) {
@@ -293,33 +334,85 @@ func (w *valueWrapper) GetText() (string, error) {
 	return val, nil
 }
 
+// writableValue is implemented by ServiceValue values that know
+// whether they currently accept a remote SetValue call.
+type writableValue interface {
+	isWritable() bool
+}
+
 func (b *baseValue) SetValue(value any) (int, *dbus.Error) {
-	b.mu.Lock()
-	defer b.mu.Unlock()
+	if w, ok := b.value.(writableValue); ok && !w.isWritable() {
+		return -1, wrapError(fmt.Errorf("%s: value is not writable", b.path))
+	}
 
-	if err := b.value.SetValue(value); err != nil {
+	text, err := b.setValueLocked(value)
+	if err != nil {
 		return -1, wrapError(err)
 	}
 
-	text, err := b.value.GetText()
-	if err != nil {
+	if err := b.emitPropertiesChanged(value, text); err != nil {
 		return -1, wrapError(err)
 	}
 
-	obj := b.service.conn.Object(b.service.name, dbus.ObjectPath(b.path))
+	return 0, nil
+}
 
-	if err := b.service.conn.Emit(
+// setValueLocked sets the value and returns its new text form without
+// emitting PropertiesChanged, so that Service.Update can apply several
+// values and emit a single ItemsChanged signal for all of them instead
+// of one PropertiesChanged per path.
+func (b *baseValue) setValueLocked(value any) (string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if err := b.value.SetValue(value); err != nil {
+		return "", err
+	}
+
+	return b.value.GetText()
+}
+
+// emitPropertiesChanged emits PropertiesChanged for b.path. It reads
+// b.service's connection under the service lock, since Service.Run
+// can swap it out for a new one concurrently with callers driving
+// values.
+func (b *baseValue) emitPropertiesChanged(value any, text string) error {
+	conn, name := b.service.connection()
+
+	obj := conn.Object(name, dbus.ObjectPath(b.path))
+
+	return conn.Emit(
 		obj.Path(),
 		"com.victronenergy.BusItem.PropertiesChanged",
 		map[string]any{
 			"Value": value,
 			"Text":  text,
 		},
-	); err != nil {
-		return -1, wrapError(err)
+	)
+}
+
+// republish re-emits the value's current PropertiesChanged signal
+// without calling SetValue on the underlying value, so that reexport
+// can resync subscribers after a reconnect without re-running
+// validation or re-invoking OnSet.
+func (b *baseValue) republish() error {
+	b.mu.Lock()
+
+	value, err := b.value.GetValue()
+	if err != nil {
+		b.mu.Unlock()
+		return err
 	}
 
-	return 0, nil
+	text, err := b.value.GetText()
+
+	b.mu.Unlock()
+
+	if err != nil {
+		return err
+	}
+
+	return b.emitPropertiesChanged(value, text)
 }
 
 func (b *baseValue) GetValue() (any, *dbus.Error) {
@@ -346,6 +439,40 @@ func (b *baseValue) GetText() (string, *dbus.Error) {
 	return text, nil
 }
 
+func (b *baseValue) GetMin() (any, *dbus.Error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	ranged, ok := b.value.(RangedValue)
+	if !ok {
+		return nil, wrapError(fmt.Errorf("%s: value does not have a minimum", b.path))
+	}
+
+	min, err := ranged.GetMin()
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	return min, nil
+}
+
+func (b *baseValue) GetMax() (any, *dbus.Error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	ranged, ok := b.value.(RangedValue)
+	if !ok {
+		return nil, wrapError(fmt.Errorf("%s: value does not have a maximum", b.path))
+	}
+
+	max, err := ranged.GetMax()
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	return max, nil
+}
+
 type FormatterValue struct {
 	formatter func(any) string
 	value     any
@@ -378,18 +505,10 @@ func (f *FormatterValue) GetText() (string, error) {
 	return f.formatter(f.value), nil
 }
 
-func (s *Service) ItemsChanged() *dbus.Error {
-	return nil
-}
-
 type dbusServiceWrapper struct {
 	service *Service
 }
 
-func (s *dbusServiceWrapper) ItemsChanged() *dbus.Error {
-	return nil
-}
-
 // dbus signature a{sa{sv}}
 func (s *dbusServiceWrapper) GetItems() (map[string]map[string]any, *dbus.Error) {
 	out := make(map[string]map[string]any)